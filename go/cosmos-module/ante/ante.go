@@ -0,0 +1,135 @@
+// Package ante provides ante handler decorators for the counter module,
+// letting transactions be signed with the same eth_secp256k1 keys the
+// Web3Client RPC client already handles.
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/pavlenkotm/ethsold/go/cosmos-module/crypto/ethsecp256k1"
+)
+
+// AccountKeeper is the subset of x/auth's AccountKeeper this decorator
+// needs to look up a signer's real account number and sequence, which
+// are part of the SIGN_MODE_DIRECT sign bytes and therefore cannot be
+// hardcoded.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// ExtensionOptionEthereumTx is the type URL a transaction's extension
+// options carry to mark it as signed with an eth_secp256k1 key,
+// mirroring the Ethermint `ExtensionOptionsEthereumTx` extension.
+const ExtensionOptionEthereumTx = "/pavlenkotm.ethsold.counter.v1.ExtensionOptionsEthereumTx"
+
+// extensionOptionsTx is implemented by the SDK's tx.Tx wrapper.
+type extensionOptionsTx interface {
+	GetExtensionOptions() []*codectypes.Any
+}
+
+// EthSecp256k1SigVerificationDecorator verifies signatures from
+// eth_secp256k1 keys using ECDSA recovery over the Keccak256 digest of
+// the sign bytes, instead of the SDK's default verifier (which assumes
+// a SHA-256 digest and a non-recoverable signature). It only intercepts
+// transactions tagged with ExtensionOptionEthereumTx; every other
+// transaction falls through to `next` unchanged, so it composes with
+// the standard SigVerificationDecorator in the ante chain.
+type EthSecp256k1SigVerificationDecorator struct {
+	accountKeeper   AccountKeeper
+	signModeHandler authsigning.SignModeHandler
+}
+
+// NewEthSecp256k1SigVerificationDecorator creates the decorator, using
+// accountKeeper to look up each signer's real account number and
+// sequence, and signModeHandler to derive the sign bytes exactly as
+// the SDK's own SigVerificationDecorator does.
+func NewEthSecp256k1SigVerificationDecorator(
+	accountKeeper AccountKeeper, signModeHandler authsigning.SignModeHandler,
+) EthSecp256k1SigVerificationDecorator {
+	return EthSecp256k1SigVerificationDecorator{accountKeeper: accountKeeper, signModeHandler: signModeHandler}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EthSecp256k1SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if !carriesEthereumTxExtension(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must implement SigVerifiableTx")
+	}
+
+	signers := sigTx.GetSigners()
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != len(signers) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "expected %d signatures, got %d", len(signers), len(sigs),
+		)
+	}
+
+	for i, sig := range sigs {
+		pubKey, ok := sig.PubKey.(*ethsecp256k1.PubKey)
+		if !ok {
+			// Not an eth_secp256k1 signer: let the default decorator
+			// verify it.
+			continue
+		}
+
+		single, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "eth_secp256k1 signatures must be single (non-multisig) signatures")
+		}
+
+		if !pubKey.AccAddress().Equals(signers[i]) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey does not match signer %s", signers[i])
+		}
+
+		acc := d.accountKeeper.GetAccount(ctx, signers[i])
+		if acc == nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", signers[i])
+		}
+
+		signerData := authsigning.SignerData{
+			Address:       pubKey.AccAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        pubKey,
+		}
+
+		signBytes, err := d.signModeHandler.GetSignBytes(single.SignMode, signerData, tx)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(err, "unable to get sign bytes")
+		}
+
+		if !pubKey.VerifySignature(signBytes, single.Signature) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed for %s", signers[i])
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func carriesEthereumTxExtension(tx sdk.Tx) bool {
+	extTx, ok := tx.(extensionOptionsTx)
+	if !ok {
+		return false
+	}
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.GetTypeUrl() == ExtensionOptionEthereumTx {
+			return true
+		}
+	}
+	return false
+}
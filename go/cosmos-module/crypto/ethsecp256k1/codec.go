@@ -0,0 +1,26 @@
+package ethsecp256k1
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func init() {
+	// PrivKey and PubKey carry `protobuf:` struct tags (see keys.go), so
+	// gogoproto's reflection-based marshaler can (de)serialize them from
+	// just this registration, without a protoc-generated Marshal pair.
+	proto.RegisterType((*PrivKey)(nil), "pavlenkotm.ethsold.counter.ethsecp256k1.PrivKey")
+	proto.RegisterType((*PubKey)(nil), "pavlenkotm.ethsold.counter.ethsecp256k1.PubKey")
+}
+
+// RegisterInterfaces registers PrivKey and PubKey as implementations of
+// the standard Cosmos SDK key interfaces, so that a signature's PubKey
+// can actually be packed into a codectypes.Any when a tx is built and
+// resolved back out of one by the InterfaceRegistry when it is decoded
+// - the step EthSecp256k1SigVerificationDecorator depends on to ever see
+// a *PubKey instead of an unregistered-type decode error.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
+}
@@ -0,0 +1,165 @@
+// Package ethsecp256k1 implements a Cosmos SDK key type that signs
+// recoverable ECDSA signatures over Keccak256 digests, matching the
+// `eth_secp256k1` key used by the Ethermint Web3-tx signing extension.
+// Because the address is derived the same way Ethereum derives it, a
+// single private key maps to one bech32 AccAddress and one 0x Ethereum
+// address.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// PrivKeySize is the number of bytes in a serialized private key.
+	PrivKeySize = 32
+	// KeyType is the string identifier for this key type, returned by
+	// PrivKey.Type and PubKey.Type.
+	KeyType = "eth_secp256k1"
+)
+
+var (
+	_ cryptotypes.PrivKey = &PrivKey{}
+	_ cryptotypes.PubKey  = &PubKey{}
+)
+
+// PrivKey wraps a raw secp256k1 private key. The `protobuf:` tag lets
+// gogoproto's reflection-based (un)marshaler — see codec.go — pack and
+// unpack it via codectypes.Any without a protoc-generated Marshal pair.
+type PrivKey struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+// GenPrivKey generates a new eth_secp256k1 private key.
+func GenPrivKey() (*PrivKey, error) {
+	key, err := ethcrypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &PrivKey{Key: ethcrypto.FromECDSA(key)}, nil
+}
+
+// PrivKeyFromHex loads a private key from its hex representation, as
+// produced by the existing Web3Client signing paths.
+func PrivKeyFromHex(hexKey string) (*PrivKey, error) {
+	key, err := ethcrypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid eth_secp256k1 hex key: %w", err)
+	}
+	return &PrivKey{Key: ethcrypto.FromECDSA(key)}, nil
+}
+
+// Bytes returns the raw private key bytes.
+func (privKey *PrivKey) Bytes() []byte {
+	bz := make([]byte, len(privKey.Key))
+	copy(bz, privKey.Key)
+	return bz
+}
+
+// Sign signs msg, returning a 65-byte recoverable signature (R || S || V)
+// over Keccak256(msg) rather than SHA-256, so it verifies the same way
+// an Ethereum wallet signature over the same bytes would.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	key, err := privKey.toECDSA()
+	if err != nil {
+		return nil, err
+	}
+	return ethcrypto.Sign(ethcrypto.Keccak256(msg), key)
+}
+
+// PubKey derives the public key for this private key.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	key, err := privKey.toECDSA()
+	if err != nil {
+		return nil
+	}
+	return &PubKey{Key: ethcrypto.FromECDSAPub(&key.PublicKey)}
+}
+
+// Equals returns true iff other is a PrivKey wrapping the same bytes.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type returns the key type identifier.
+func (privKey *PrivKey) Type() string { return KeyType }
+
+func (privKey *PrivKey) Reset() { *privKey = PrivKey{} }
+func (privKey *PrivKey) String() string {
+	return fmt.Sprintf("PrivKeyEthSecp256k1{%X}", privKey.Key)
+}
+func (privKey *PrivKey) ProtoMessage() {}
+
+func (privKey *PrivKey) toECDSA() (*ecdsa.PrivateKey, error) {
+	return ethcrypto.ToECDSA(privKey.Key)
+}
+
+// PubKey wraps an uncompressed secp256k1 public key. The `protobuf:` tag
+// lets gogoproto's reflection-based (un)marshaler — see codec.go — pack
+// and unpack it via codectypes.Any without a protoc-generated Marshal
+// pair.
+type PubKey struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+// Address returns the last 20 bytes of Keccak256(uncompressed pubkey[1:]),
+// i.e. the standard Ethereum address for this key, so that the bech32
+// AccAddress built from it maps 1:1 to a 0x Ethereum address.
+func (pubKey *PubKey) Address() cryptotypes.Address {
+	key, err := ethcrypto.UnmarshalPubkey(pubKey.Key)
+	if err != nil {
+		return nil
+	}
+	return cryptotypes.Address(ethcrypto.PubkeyToAddress(*key).Bytes())
+}
+
+// Bytes returns the raw, uncompressed public key bytes.
+func (pubKey *PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+	return bz
+}
+
+// VerifySignature verifies a 65-byte recoverable signature over
+// Keccak256(msg), recovering the signer's public key and comparing it
+// against pubKey rather than calling the default ECDSA verifier (which
+// expects a SHA-256 digest and a non-recoverable signature).
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(sig) != 65 {
+		return false
+	}
+
+	recovered, err := ethcrypto.SigToPub(ethcrypto.Keccak256(msg), sig)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ethcrypto.FromECDSAPub(recovered), pubKey.Key)
+}
+
+// Equals returns true iff other is a PubKey wrapping the same bytes.
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// Type returns the key type identifier.
+func (pubKey *PubKey) Type() string { return KeyType }
+
+func (pubKey *PubKey) Reset() { *pubKey = PubKey{} }
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+func (pubKey *PubKey) ProtoMessage() {}
+
+// AccAddress returns the sdk.AccAddress derived from this public key,
+// i.e. sdk.AccAddress(pubKey.Address()).
+func (pubKey *PubKey) AccAddress() sdk.AccAddress {
+	return sdk.AccAddress(pubKey.Address().Bytes())
+}
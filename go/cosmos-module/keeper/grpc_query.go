@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pavlenkotm/ethsold/go/cosmos-module/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Counter implements types.QueryServer.
+func (k Keeper) Counter(goCtx context.Context, req *types.QueryCounterRequest) (*types.QueryCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryCounterResponse{Counter: k.GetCounter(ctx)}, nil
+}
+
+// UserCount implements types.QueryServer.
+func (k Keeper) UserCount(goCtx context.Context, req *types.QueryUserCountRequest) (*types.QueryUserCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryUserCountResponse{Count: k.GetUserIncrementCount(ctx, req.Address)}, nil
+}
@@ -7,6 +7,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/pavlenkotm/ethsold/go/cosmos-module/types"
 )
 
 // Keeper maintains the link to storage and exposes getter/setter methods
@@ -54,53 +56,51 @@ func (k Keeper) SetCounter(ctx sdk.Context, counter int64) {
 	store.Set([]byte("counter"), bz)
 }
 
-// IncrementCounter increments the counter by 1
-func (k Keeper) IncrementCounter(ctx sdk.Context) int64 {
+// IncrementCounter increments the counter by 1 and emits a typed
+// EventIncremented for the given creator. The new counter value is
+// always persisted even if the event fails to marshal, so a caller must
+// check the returned error rather than assume the state change rolled
+// back with it.
+func (k Keeper) IncrementCounter(ctx sdk.Context, creator string) (int64, error) {
 	counter := k.GetCounter(ctx)
 	counter++
 	k.SetCounter(ctx, counter)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"counter_incremented",
-			sdk.NewAttribute("value", fmt.Sprintf("%d", counter)),
-		),
-	)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventIncremented{
+		Creator:  creator,
+		NewValue: counter,
+	})
 
-	return counter
+	return counter, err
 }
 
-// DecrementCounter decrements the counter by 1
-func (k Keeper) DecrementCounter(ctx sdk.Context) int64 {
+// DecrementCounter decrements the counter by 1 (floored at 0) and emits
+// a typed EventDecremented for the given creator.
+func (k Keeper) DecrementCounter(ctx sdk.Context, creator string) (int64, error) {
 	counter := k.GetCounter(ctx)
-	if counter > 0 {
-		counter--
-		k.SetCounter(ctx, counter)
-
-		// Emit event
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				"counter_decremented",
-				sdk.NewAttribute("value", fmt.Sprintf("%d", counter)),
-			),
-		)
+	if counter == 0 {
+		return counter, nil
 	}
 
-	return counter
+	counter--
+	k.SetCounter(ctx, counter)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventDecremented{
+		Creator:  creator,
+		NewValue: counter,
+	})
+
+	return counter, err
 }
 
-// ResetCounter resets the counter to zero
-func (k Keeper) ResetCounter(ctx sdk.Context) {
+// ResetCounter resets the counter to zero and emits a typed EventReset
+// for the given creator.
+func (k Keeper) ResetCounter(ctx sdk.Context, creator string) error {
 	k.SetCounter(ctx, 0)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"counter_reset",
-			sdk.NewAttribute("value", "0"),
-		),
-	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventReset{
+		Creator: creator,
+	})
 }
 
 // GetUserIncrementCount retrieves the increment count for a specific user
@@ -127,3 +127,15 @@ func (k Keeper) IncrementUserCount(ctx sdk.Context, address string) {
 	bz := k.cdc.MustMarshal(&count)
 	store.Set(key, bz)
 }
+
+// GetUserIncrementCountByEthAddress is a convenience wrapper over
+// GetUserIncrementCount for callers holding a 0x Ethereum address (e.g.
+// one signing with an ethsecp256k1.PrivKey) rather than a bech32
+// AccAddress.
+func (k Keeper) GetUserIncrementCountByEthAddress(ctx sdk.Context, ethAddr string) (int64, error) {
+	accAddr, err := types.EthAddressToAccAddress(ethAddr)
+	if err != nil {
+		return 0, err
+	}
+	return k.GetUserIncrementCount(ctx, accAddr.String()), nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/pavlenkotm/ethsold/go/cosmos-module/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of types.MsgServer backed
+// by the given Keeper, so the counter Msg types can actually be
+// dispatched by a baseapp MsgServiceRouter.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Increment implements types.MsgServer.
+func (k msgServer) Increment(goCtx context.Context, msg *types.MsgIncrement) (*types.MsgIncrementResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	newValue, err := k.Keeper.IncrementCounter(ctx, msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to emit increment event")
+	}
+	k.Keeper.IncrementUserCount(ctx, msg.Creator)
+
+	return &types.MsgIncrementResponse{NewValue: newValue}, nil
+}
+
+// Decrement implements types.MsgServer.
+func (k msgServer) Decrement(goCtx context.Context, msg *types.MsgDecrement) (*types.MsgDecrementResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	newValue, err := k.Keeper.DecrementCounter(ctx, msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to emit decrement event")
+	}
+
+	return &types.MsgDecrementResponse{NewValue: newValue}, nil
+}
+
+// Reset implements types.MsgServer.
+func (k msgServer) Reset(goCtx context.Context, msg *types.MsgReset) (*types.MsgResetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResetCounter(ctx, msg.Creator); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to emit reset event")
+	}
+
+	return &types.MsgResetResponse{}, nil
+}
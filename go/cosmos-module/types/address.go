@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthAddressToAccAddress converts a 0x-prefixed Ethereum address into
+// the sdk.AccAddress that wraps the same 20 bytes. Because
+// ethsecp256k1.PubKey derives its Address the same way go-ethereum
+// does, this is the inverse of taking the bech32 address of an
+// eth_secp256k1 key, letting callers key state (e.g.
+// Keeper.GetUserIncrementCount) by either representation.
+func EthAddressToAccAddress(ethAddr string) (sdk.AccAddress, error) {
+	if !common.IsHexAddress(ethAddr) {
+		return nil, fmt.Errorf("invalid ethereum address: %s", ethAddr)
+	}
+	return sdk.AccAddress(common.HexToAddress(ethAddr).Bytes()), nil
+}
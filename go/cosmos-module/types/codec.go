@@ -4,7 +4,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pavlenkotm/ethsold/go/cosmos-module/crypto/ethsecp256k1"
 )
 
 // RegisterCodec registers concrete types on codec
@@ -22,7 +24,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgReset{},
 	)
 
-	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&EventIncremented{},
+		&EventDecremented{},
+		&EventReset{},
+	)
+
+	ethsecp256k1.RegisterInterfaces(registry)
+
+	// NOTE: the Msg service is hand-implemented in keeper.NewMsgServerImpl
+	// rather than generated by protoc, so there is no grpc.ServiceDesc to
+	// register here via msgservice.RegisterMsgServiceDesc. The Query
+	// service is also hand-implemented, but query_grpc.go hand-writes the
+	// grpc.ServiceDesc a protoc-gen-go-grpc Query would have produced, so
+	// RegisterQueryServer is available to wire it into a real grpc.Server.
 }
 
 var (
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+func init() {
+	// Each event struct below carries `protobuf:` tags, so gogoproto's
+	// reflection-based marshaler can (de)serialize it from just this
+	// registration, without a protoc-generated Marshal pair.
+	proto.RegisterType((*EventIncremented)(nil), "pavlenkotm.ethsold.counter.v1.EventIncremented")
+	proto.RegisterType((*EventDecremented)(nil), "pavlenkotm.ethsold.counter.v1.EventDecremented")
+	proto.RegisterType((*EventReset)(nil), "pavlenkotm.ethsold.counter.v1.EventReset")
+}
+
+var (
+	_ proto.Message = &EventIncremented{}
+	_ proto.Message = &EventDecremented{}
+	_ proto.Message = &EventReset{}
+)
+
+// EventIncremented is emitted via EmitTypedEvent whenever the counter is
+// incremented.
+type EventIncremented struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewValue int64  `protobuf:"varint,2,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (e *EventIncremented) Reset() { *e = EventIncremented{} }
+func (e *EventIncremented) String() string {
+	return fmt.Sprintf("EventIncremented{Creator: %s, NewValue: %d}", e.Creator, e.NewValue)
+}
+func (e *EventIncremented) ProtoMessage() {}
+
+// EventDecremented is emitted via EmitTypedEvent whenever the counter is
+// decremented.
+type EventDecremented struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewValue int64  `protobuf:"varint,2,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (e *EventDecremented) Reset() { *e = EventDecremented{} }
+func (e *EventDecremented) String() string {
+	return fmt.Sprintf("EventDecremented{Creator: %s, NewValue: %d}", e.Creator, e.NewValue)
+}
+func (e *EventDecremented) ProtoMessage() {}
+
+// EventReset is emitted via EmitTypedEvent whenever the counter is reset
+// to zero.
+type EventReset struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (e *EventReset) Reset() { *e = EventReset{} }
+func (e *EventReset) String() string {
+	return fmt.Sprintf("EventReset{Creator: %s}", e.Creator)
+}
+func (e *EventReset) ProtoMessage() {}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+func init() {
+	// Each request/response struct below carries `protobuf:` tags, so
+	// gogoproto's reflection-based marshaler can (de)serialize it from
+	// just this registration — see query_grpc.go for how that lets the
+	// Query service be wired into a real grpc.Server.
+	proto.RegisterType((*QueryCounterRequest)(nil), "pavlenkotm.ethsold.counter.v1.QueryCounterRequest")
+	proto.RegisterType((*QueryCounterResponse)(nil), "pavlenkotm.ethsold.counter.v1.QueryCounterResponse")
+	proto.RegisterType((*QueryUserCountRequest)(nil), "pavlenkotm.ethsold.counter.v1.QueryUserCountRequest")
+	proto.RegisterType((*QueryUserCountResponse)(nil), "pavlenkotm.ethsold.counter.v1.QueryUserCountResponse")
+}
+
+// QueryServer is the server API for the counter module's Query service.
+type QueryServer interface {
+	Counter(context.Context, *QueryCounterRequest) (*QueryCounterResponse, error)
+	UserCount(context.Context, *QueryUserCountRequest) (*QueryUserCountResponse, error)
+}
+
+// QueryCounterRequest is the request type for the Query.Counter RPC.
+type QueryCounterRequest struct{}
+
+func (m *QueryCounterRequest) Reset()         { *m = QueryCounterRequest{} }
+func (m *QueryCounterRequest) String() string { return "QueryCounterRequest{}" }
+func (m *QueryCounterRequest) ProtoMessage()  {}
+
+// QueryCounterResponse is the response type for the Query.Counter RPC.
+type QueryCounterResponse struct {
+	Counter int64 `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+}
+
+func (m *QueryCounterResponse) Reset() { *m = QueryCounterResponse{} }
+func (m *QueryCounterResponse) String() string {
+	return fmt.Sprintf("QueryCounterResponse{Counter: %d}", m.Counter)
+}
+func (m *QueryCounterResponse) ProtoMessage() {}
+
+// QueryUserCountRequest is the request type for the Query.UserCount RPC.
+type QueryUserCountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryUserCountRequest) Reset() { *m = QueryUserCountRequest{} }
+func (m *QueryUserCountRequest) String() string {
+	return fmt.Sprintf("QueryUserCountRequest{Address: %s}", m.Address)
+}
+func (m *QueryUserCountRequest) ProtoMessage() {}
+
+// QueryUserCountResponse is the response type for the Query.UserCount RPC.
+type QueryUserCountResponse struct {
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryUserCountResponse) Reset() { *m = QueryUserCountResponse{} }
+func (m *QueryUserCountResponse) String() string {
+	return fmt.Sprintf("QueryUserCountResponse{Count: %d}", m.Count)
+}
+func (m *QueryUserCountResponse) ProtoMessage() {}
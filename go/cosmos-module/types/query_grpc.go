@@ -0,0 +1,67 @@
+package types
+
+import (
+	"context"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// RegisterQueryServer registers srv with s, the way a protoc-gen-go-grpc
+// generated RegisterQueryServer would, so the counter module's Query
+// service can be dispatched by baseapp's gRPC query router rather than
+// only being reachable by calling a Keeper method directly in-process.
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Counter_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(QueryCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Counter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pavlenkotm.ethsold.counter.v1.Query/Counter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Counter(ctx, req.(*QueryCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserCount_Handler(
+	srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(QueryUserCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pavlenkotm.ethsold.counter.v1.Query/UserCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserCount(ctx, req.(*QueryUserCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pavlenkotm.ethsold.counter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Counter", Handler: _Query_Counter_Handler},
+		{MethodName: "UserCount", Handler: _Query_UserCount_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "counter/query.proto",
+}
@@ -0,0 +1,23 @@
+package types
+
+import "context"
+
+// MsgServer is the server API for the counter module's Msg service.
+type MsgServer interface {
+	Increment(context.Context, *MsgIncrement) (*MsgIncrementResponse, error)
+	Decrement(context.Context, *MsgDecrement) (*MsgDecrementResponse, error)
+	Reset(context.Context, *MsgReset) (*MsgResetResponse, error)
+}
+
+// MsgIncrementResponse is the response to a MsgIncrement.
+type MsgIncrementResponse struct {
+	NewValue int64 `json:"new_value"`
+}
+
+// MsgDecrementResponse is the response to a MsgDecrement.
+type MsgDecrementResponse struct {
+	NewValue int64 `json:"new_value"`
+}
+
+// MsgResetResponse is the response to a MsgReset.
+type MsgResetResponse struct{}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// CallContract invokes a read-only contract method at the latest block
+// and unpacks the result into typed Go values per the ABI.
+func (w *Web3Client) CallContract(address string, abiJSON string, method string, args ...interface{}) ([]interface{}, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	input, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack call data: %w", err)
+	}
+
+	contractAddr := common.HexToAddress(address)
+	output, err := w.client.CallContract(w.ctx, ethereum.CallMsg{
+		To:   &contractAddr,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call failed: %w", err)
+	}
+
+	return parsedABI.Unpack(method, output)
+}
+
+// SendContractTx packs calldata for method, estimates gas, and submits a
+// signed dynamic-fee (EIP-1559) transaction invoking it.
+func (w *Web3Client) SendContractTx(privateKeyHex string, address string, abiJSON string, method string, args ...interface{}) (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	input, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to pack call data: %w", err)
+	}
+
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	if err != nil {
+		return "", err
+	}
+	publicKeyECDSA, ok := privateKey.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("error casting public key")
+	}
+	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
+
+	gasLimit, err := w.EstimateGas(fromAddress.Hex(), address, nil, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	return w.SendDynamicFeeTx(privateKeyHex, address, nil, input, gasLimit)
+}
+
+// WatchLogs subscribes to query over a dedicated ws://wss:// connection
+// and invokes handler with the decoded indexed and non-indexed fields
+// of every log matching eventName in the ABI. Subscriptions require a
+// persistent connection, so it returns an error unless w.rpcURL is a
+// ws:// or wss:// URL. It dials its own connection to w.rpcURL rather
+// than reusing w.client, and closes that connection when the
+// subscription ends.
+func (w *Web3Client) WatchLogs(ctx context.Context, query ethereum.FilterQuery, abiJSON string, eventName string, handler func(map[string]interface{})) error {
+	if !strings.HasPrefix(w.rpcURL, "ws://") && !strings.HasPrefix(w.rpcURL, "wss://") {
+		return fmt.Errorf("WatchLogs requires a ws:// or wss:// RPC URL, got %q", w.rpcURL)
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	event, ok := parsedABI.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %q not found in ABI", eventName)
+	}
+
+	wsClient, err := ethclient.DialContext(ctx, w.rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial websocket RPC: %w", err)
+	}
+
+	logs := make(chan types.Log)
+	sub, err := wsClient.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		wsClient.Close()
+		return fmt.Errorf("failed to subscribe to logs: %w", err)
+	}
+
+	go func() {
+		defer wsClient.Close()
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-sub.Err():
+				// The error channel delivers at most once, then the
+				// subscription is dead either way: stop regardless of
+				// whether the delivered value was nil.
+				return
+			case vLog := <-logs:
+				decoded, err := decodeLog(parsedABI, event, vLog)
+				if err != nil {
+					continue
+				}
+				handler(decoded)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// decodeLog unpacks a log's non-indexed data per the ABI event
+// definition and decodes its indexed topics, merging both into a single
+// field-name-to-value map.
+func decodeLog(parsedABI abi.ABI, event abi.Event, vLog types.Log) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	if len(vLog.Data) > 0 {
+		if err := parsedABI.UnpackIntoMap(result, event.Name, vLog.Data); err != nil {
+			return nil, fmt.Errorf("failed to unpack log data: %w", err)
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	if len(vLog.Topics) > 1 {
+		if err := abi.ParseTopicsIntoMap(result, indexed, vLog.Topics[1:]); err != nil {
+			return nil, fmt.Errorf("failed to decode indexed topics: %w", err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const transferEventABI = `[{
+	"anonymous": false,
+	"inputs": [
+		{"indexed": true, "name": "from", "type": "address"},
+		{"indexed": true, "name": "to", "type": "address"},
+		{"indexed": false, "name": "value", "type": "uint256"}
+	],
+	"name": "Transfer",
+	"type": "event"
+}]`
+
+func TestDecodeLogSplitsIndexedAndNonIndexedFields(t *testing.T) {
+	parsedABI, err := abi.JSON(strings.NewReader(transferEventABI))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	event := parsedABI.Events["Transfer"]
+
+	from := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	value := big.NewInt(42)
+
+	data, err := parsedABI.Events["Transfer"].Inputs.NonIndexed().Pack(value)
+	if err != nil {
+		t.Fatalf("Failed to pack non-indexed args: %v", err)
+	}
+
+	vLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte(event.Sig)),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: data,
+	}
+
+	decoded, err := decodeLog(parsedABI, event, vLog)
+	if err != nil {
+		t.Fatalf("decodeLog failed: %v", err)
+	}
+
+	if decoded["from"].(common.Address) != from {
+		t.Errorf("expected from %s, got %v", from.Hex(), decoded["from"])
+	}
+	if decoded["to"].(common.Address) != to {
+		t.Errorf("expected to %s, got %v", to.Hex(), decoded["to"])
+	}
+	if decoded["value"].(*big.Int).Cmp(value) != 0 {
+		t.Errorf("expected value %s, got %v", value.String(), decoded["value"])
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewGasOracleDefaultMultiplier(t *testing.T) {
+	oracle := NewGasOracle(nil, nil)
+	bfo, ok := oracle.(*baseFeeGasOracle)
+	if !ok {
+		t.Fatal("Expected NewGasOracle to return a *baseFeeGasOracle")
+	}
+	if bfo.multiplier.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("Expected default multiplier 2, got %s", bfo.multiplier.String())
+	}
+}
+
+func TestNewGasOracleCustomMultiplier(t *testing.T) {
+	oracle := NewGasOracle(nil, big.NewInt(3))
+	bfo, ok := oracle.(*baseFeeGasOracle)
+	if !ok {
+		t.Fatal("Expected NewGasOracle to return a *baseFeeGasOracle")
+	}
+	if bfo.multiplier.Cmp(big.NewInt(3)) != 0 {
+		t.Errorf("Expected multiplier 3, got %s", bfo.multiplier.String())
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestRawKeySignerAddress(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	privateKeyHex := common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	signer, err := NewRawKeySigner(privateKeyHex)
+	if err != nil {
+		t.Fatalf("NewRawKeySigner failed: %v", err)
+	}
+
+	expected := crypto.PubkeyToAddress(privateKey.PublicKey)
+	if signer.Address() != expected {
+		t.Errorf("expected address %s, got %s", expected.Hex(), signer.Address().Hex())
+	}
+}
+
+func TestRawKeySignerSignHash(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	privateKeyHex := common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	signer, err := NewRawKeySigner(privateKeyHex)
+	if err != nil {
+		t.Fatalf("NewRawKeySigner failed: %v", err)
+	}
+
+	hash := crypto.Keccak256([]byte("hello"))
+	sig, err := signer.SignHash(hash)
+	if err != nil {
+		t.Fatalf("SignHash failed: %v", err)
+	}
+	if len(sig) != 65 {
+		t.Errorf("expected 65-byte signature, got %d bytes", len(sig))
+	}
+}
+
+func TestReadPassphraseFromEnv(t *testing.T) {
+	t.Setenv(ethKeystorePasswordEnv, "correct horse battery staple")
+
+	pw, err := readPassphrase()
+	if err != nil {
+		t.Fatalf("readPassphrase failed: %v", err)
+	}
+	if pw != "correct horse battery staple" {
+		t.Errorf("expected passphrase from env, got %q", pw)
+	}
+}
+
+func TestKeystoreDirDefault(t *testing.T) {
+	os.Unsetenv("ETH_KEYSTORE_DIR")
+	if dir := keystoreDir(); dir != "./keystore" {
+		t.Errorf("expected default keystore dir, got %q", dir)
+	}
+}
@@ -17,8 +17,10 @@ import (
 
 // Web3Client manages Ethereum RPC interactions
 type Web3Client struct {
-	client *ethclient.Client
-	ctx    context.Context
+	client    *ethclient.Client
+	ctx       context.Context
+	gasOracle GasOracle
+	rpcURL    string
 }
 
 // NewWeb3Client creates a new Web3 client
@@ -29,8 +31,10 @@ func NewWeb3Client(rpcURL string) (*Web3Client, error) {
 	}
 
 	return &Web3Client{
-		client: client,
-		ctx:    context.Background(),
+		client:    client,
+		ctx:       context.Background(),
+		gasOracle: NewGasOracle(client, nil),
+		rpcURL:    rpcURL,
 	}, nil
 }
 
@@ -63,11 +67,54 @@ func (w *Web3Client) GetTransaction(txHash string) (*types.Transaction, bool, er
 	return tx, isPending, nil
 }
 
-// SendTransaction sends a signed transaction
+// SendTransaction sends a signed transaction using signer, so callers
+// never have to hand the client a plaintext private key.
 func (w *Web3Client) SendTransaction(
+	signer Signer,
+	toAddress string,
+	amount *big.Int,
+) (string, error) {
+	fromAddress := signer.Address()
+	nonce, err := w.client.PendingNonceAt(w.ctx, fromAddress)
+	if err != nil {
+		return "", err
+	}
+
+	gasLimit := uint64(21000)
+	gasPrice, err := w.client.SuggestGasPrice(w.ctx)
+	if err != nil {
+		return "", err
+	}
+
+	toAddr := common.HexToAddress(toAddress)
+	tx := types.NewTransaction(nonce, toAddr, amount, gasLimit, gasPrice, nil)
+
+	chainID, err := w.client.NetworkID(w.ctx)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := signer.SignTx(tx, chainID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := w.client.SendTransaction(w.ctx, signedTx); err != nil {
+		return "", err
+	}
+
+	return signedTx.Hash().Hex(), nil
+}
+
+// SendDynamicFeeTx sends an EIP-1559 type-2 transaction, pricing it via
+// w.gasOracle instead of SuggestGasPrice, so it also works on chains
+// that reject legacy transactions.
+func (w *Web3Client) SendDynamicFeeTx(
 	privateKeyHex string,
 	toAddress string,
 	amount *big.Int,
+	data []byte,
+	gasLimit uint64,
 ) (string, error) {
 	privateKey, err := crypto.HexToECDSA(privateKeyHex)
 	if err != nil {
@@ -86,30 +133,43 @@ func (w *Web3Client) SendTransaction(
 		return "", err
 	}
 
-	gasLimit := uint64(21000)
-	gasPrice, err := w.client.SuggestGasPrice(w.ctx)
+	chainID, err := w.client.NetworkID(w.ctx)
 	if err != nil {
 		return "", err
 	}
 
-	toAddr := common.HexToAddress(toAddress)
-	tx := types.NewTransaction(nonce, toAddr, amount, gasLimit, gasPrice, nil)
-
-	chainID, err := w.client.NetworkID(w.ctx)
+	tipCap, err := w.gasOracle.SuggestGasTipCap(w.ctx)
 	if err != nil {
 		return "", err
 	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
+	feeCap, err := w.gasOracle.SuggestGasFeeCap(w.ctx, tipCap)
 	if err != nil {
 		return "", err
 	}
 
-	err = w.client.SendTransaction(w.ctx, signedTx)
+	toAddr := common.HexToAddress(toAddress)
+	txData := &types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		GasTipCap:  tipCap,
+		GasFeeCap:  feeCap,
+		Gas:        gasLimit,
+		To:         &toAddr,
+		Value:      amount,
+		Data:       data,
+		AccessList: nil,
+	}
+
+	signedTx, err := types.SignTx(types.NewTx(txData), types.LatestSignerForChainID(chainID), privateKey)
 	if err != nil {
 		return "", err
 	}
 
+	if err := w.client.SendTransaction(w.ctx, signedTx); err != nil {
+		return "", err
+	}
+
 	return signedTx.Hash().Hex(), nil
 }
 
@@ -136,30 +196,47 @@ func VerifySignature(message string, signatureHex string, expectedAddress string
 	data := []byte(message)
 	hash := crypto.Keccak256Hash(data)
 
-	signature, err := hexutil.Decode(signatureHex)
+	recoveredAddress, err := recoverAddress(hash, signatureHex)
 	if err != nil {
 		return false, err
 	}
 
+	expected := common.HexToAddress(expectedAddress)
+	return recoveredAddress == expected, nil
+}
+
+// recoverAddress recovers the signer address from a hash and a 65-byte
+// signature, normalizing the trailing recovery byte so that signatures
+// using either the Ecrecover convention (0/1) or the Ethereum wallet
+// convention (27/28) verify correctly.
+func recoverAddress(hash common.Hash, signatureHex string) (common.Address, error) {
+	signature, err := hexutil.Decode(signatureHex)
+	if err != nil {
+		return common.Address{}, err
+	}
+
 	// Signature must be 65 bytes (32 + 32 + 1) including recovery ID
 	if len(signature) != 65 {
-		return false, fmt.Errorf("invalid signature length: expected 65 bytes, got %d", len(signature))
+		return common.Address{}, fmt.Errorf("invalid signature length: expected 65 bytes, got %d", len(signature))
 	}
 
-	publicKeyBytes, err := crypto.Ecrecover(hash.Bytes(), signature)
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	publicKeyBytes, err := crypto.Ecrecover(hash.Bytes(), sig)
 	if err != nil {
-		return false, err
+		return common.Address{}, err
 	}
 
 	publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
 	if err != nil {
-		return false, err
+		return common.Address{}, err
 	}
 
-	recoveredAddress := crypto.PubkeyToAddress(*publicKey)
-	expected := common.HexToAddress(expectedAddress)
-
-	return recoveredAddress == expected, nil
+	return crypto.PubkeyToAddress(*publicKey), nil
 }
 
 func main() {
@@ -170,11 +247,18 @@ Web3 Go Client
 Usage:
   go run main.go balance <address>         Get ETH balance
   go run main.go block                     Get latest block number
-  go run main.go sign <key> <message>      Sign message
-  go run main.go send <key> <to> <amount>  Send ETH (in wei)
+  go run main.go sign <key> <message>      Sign message (raw Keccak256)
+  go run main.go sign-personal <key> <msg> Sign message (personal_sign / EIP-191)
+  go run main.go send <key> <to> <amount>       Send ETH (legacy tx, in wei)
+  go run main.go send-1559 <key> <to> <amount>  Send ETH (EIP-1559 dynamic-fee tx, in wei)
+  go run main.go keystore create                Create an encrypted keystore account
+  go run main.go keystore import <key>           Import a hex private key into the keystore
+  go run main.go send-ks <keyfile> <to> <amount> Send ETH signed from a keystore file
 
 Environment:
-  ETH_RPC_URL - Ethereum RPC URL (default: http://localhost:8545)
+  ETH_RPC_URL          - Ethereum RPC URL (default: http://localhost:8545)
+  ETH_KEYSTORE_DIR     - Keystore directory (default: ./keystore)
+  ETH_KEYSTORE_PASSWORD - Keystore passphrase (prompted on stdin if unset)
 		`)
 		return
 	}
@@ -219,6 +303,16 @@ Environment:
 		}
 		fmt.Printf("Signature: %s\n", signature)
 
+	case "sign-personal":
+		if len(os.Args) < 4 {
+			log.Fatal("Usage: go run main.go sign-personal <private_key> <message>")
+		}
+		signature, err := EthPersonalSign(os.Args[2], os.Args[3])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Signature: %s\n", signature)
+
 	case "send":
 		if len(os.Args) < 5 {
 			log.Fatal("Usage: go run main.go send <key> <to> <amount_wei>")
@@ -229,7 +323,85 @@ Environment:
 			log.Fatalf("Invalid amount: %s (must be a valid number)", os.Args[4])
 		}
 
-		txHash, err := client.SendTransaction(os.Args[2], os.Args[3], amount)
+		signer, err := NewRawKeySigner(os.Args[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+		txHash, err := client.SendTransaction(signer, os.Args[3], amount)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Transaction sent: %s\n", txHash)
+
+	case "send-1559":
+		if len(os.Args) < 5 {
+			log.Fatal("Usage: go run main.go send-1559 <key> <to> <amount_wei>")
+		}
+		amount := new(big.Int)
+		ok := amount.SetString(os.Args[4], 10)
+		if !ok {
+			log.Fatalf("Invalid amount: %s (must be a valid number)", os.Args[4])
+		}
+
+		txHash, err := client.SendDynamicFeeTx(os.Args[2], os.Args[3], amount, nil, 21000)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Transaction sent: %s\n", txHash)
+
+	case "keystore":
+		if len(os.Args) < 3 {
+			log.Fatal("Usage: go run main.go keystore <create|import> [hex_key]")
+		}
+
+		passphrase, err := readPassphrase()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		switch os.Args[2] {
+		case "create":
+			path, err := CreateKeystoreAccount(keystoreDir(), passphrase)
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("Created keystore account: %s\n", path)
+
+		case "import":
+			if len(os.Args) < 4 {
+				log.Fatal("Usage: go run main.go keystore import <hex_key>")
+			}
+			path, err := ImportKeystoreAccount(keystoreDir(), os.Args[3], passphrase)
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("Imported keystore account: %s\n", path)
+
+		default:
+			log.Fatal("Unknown keystore subcommand:", os.Args[2])
+		}
+
+	case "send-ks":
+		if len(os.Args) < 5 {
+			log.Fatal("Usage: go run main.go send-ks <keyfile> <to> <amount_wei>")
+		}
+		amount := new(big.Int)
+		ok := amount.SetString(os.Args[4], 10)
+		if !ok {
+			log.Fatalf("Invalid amount: %s (must be a valid number)", os.Args[4])
+		}
+
+		passphrase, err := readPassphrase()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		signer, err := OpenKeystoreSigner(keystoreDir(), os.Args[2], passphrase)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		txHash, err := client.SendTransaction(signer, os.Args[3], amount)
 		if err != nil {
 			log.Fatal(err)
 		}
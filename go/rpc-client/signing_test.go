@@ -0,0 +1,113 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func TestEthPersonalSignRoundTrip(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	privateKeyHex := common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	message := "Hello, MetaMask!"
+
+	signature, err := EthPersonalSign(privateKeyHex, message)
+	if err != nil {
+		t.Fatalf("EthPersonalSign failed: %v", err)
+	}
+
+	valid, err := VerifyEthPersonalSign(message, signature, address)
+	if err != nil {
+		t.Fatalf("VerifyEthPersonalSign failed: %v", err)
+	}
+	if !valid {
+		t.Error("Expected signature to verify against signing address")
+	}
+}
+
+func TestVerifySignatureAcceptsWalletRecoveryByte(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	privateKeyHex := common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	message := "Test message"
+
+	signature, err := SignMessage(privateKeyHex, message)
+	if err != nil {
+		t.Fatalf("SignMessage failed: %v", err)
+	}
+
+	// Bump the recovery byte into the wallet-style 27/28 form and verify
+	// VerifySignature still recovers the correct address.
+	raw := common.FromHex(signature)
+	raw[64] += 27
+	walletStyle := hexEncode(raw)
+
+	valid, err := VerifySignature(message, walletStyle, address)
+	if err != nil {
+		t.Fatalf("VerifySignature failed: %v", err)
+	}
+	if !valid {
+		t.Error("Expected VerifySignature to normalize the 27/28 recovery byte")
+	}
+}
+
+func TestEIP712SignRoundTrip(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	privateKeyHex := common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": []apitypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Mail": []apitypes.Type{
+				{Name: "from", Type: "string"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "ethsold",
+			ChainId: math.NewHexOrDecimal256(1),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     address,
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	signature, err := EIP712Sign(privateKeyHex, typedData)
+	if err != nil {
+		t.Fatalf("EIP712Sign failed: %v", err)
+	}
+
+	valid, err := VerifyTypedData(typedData, signature, address)
+	if err != nil {
+		t.Fatalf("VerifyTypedData failed: %v", err)
+	}
+	if !valid {
+		t.Error("Expected typed-data signature to verify against signing address")
+	}
+}
+
+func hexEncode(b []byte) string {
+	return "0x" + common.Bytes2Hex(b)
+}